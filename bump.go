@@ -0,0 +1,69 @@
+package sem
+
+// IncMajor returns a new Version with the major version incremented by one
+// and the minor, patch, prerelease, and meta reset. v is left unmodified.
+func (v *Version) IncMajor() *Version {
+	return &Version{Normal: [3]int{v.Normal[0] + 1, 0, 0}}
+}
+
+// IncMinor returns a new Version with the minor version incremented by one
+// and the patch, prerelease, and meta reset. v is left unmodified.
+func (v *Version) IncMinor() *Version {
+	return &Version{Normal: [3]int{v.Normal[0], v.Normal[1] + 1, 0}}
+}
+
+// IncPatch returns a new Version with the patch version incremented by one
+// and the prerelease and meta reset. If v already has a prerelease, IncPatch
+// instead drops the prerelease and meta and keeps the patch version as is,
+// since the current patch has not yet been released. v is left unmodified.
+func (v *Version) IncPatch() *Version {
+	if len(v.Prerelease) > 0 {
+		return &Version{Normal: v.Normal}
+	}
+	return &Version{Normal: [3]int{v.Normal[0], v.Normal[1], v.Normal[2] + 1}}
+}
+
+// WithPrerelease returns a new Version with its normal version and meta
+// copied from v and its prerelease set to ids. Each id is validated against
+// the same character rules New uses, and an invalid id is reported with a
+// ParseError. v is left unmodified.
+func (v *Version) WithPrerelease(ids ...string) (*Version, error) {
+	offset := 0
+	for _, id := range ids {
+		for i, r := range id {
+			if (r < '0' || r > '9') &&
+				(r < 'a' || r > 'z') &&
+				(r < 'A' || r > 'Z') &&
+				r != '-' {
+				return nil, ParseError{"prerelease", r, offset + i}
+			}
+		}
+		offset += len(id) + 1
+	}
+	return &Version{
+		Normal:     v.Normal,
+		Prerelease: append([]string(nil), ids...),
+		Meta:       v.Meta,
+	}, nil
+}
+
+// WithMeta returns a new Version with its normal version and prerelease
+// copied from v and its meta set to s. s is validated against the same
+// character rules New uses, and an invalid character is reported with a
+// ParseError. v is left unmodified.
+func (v *Version) WithMeta(s string) (*Version, error) {
+	for i, r := range s {
+		if (r < '0' || r > '9') &&
+			(r < 'a' || r > 'z') &&
+			(r < 'A' || r > 'Z') &&
+			r != '-' &&
+			r != '.' {
+			return nil, ParseError{"meta", r, i}
+		}
+	}
+	return &Version{
+		Normal:     v.Normal,
+		Prerelease: v.Prerelease,
+		Meta:       s,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package sem
+
+import "testing"
+
+func TestIncMajor(t *testing.T) {
+	v := mustNew(t, "1.2.3-beta+meta")
+	got := v.IncMajor()
+	if got.String() != "2.0.0" {
+		t.Errorf("IncMajor: want 2.0.0, got %s", got)
+	}
+	if v.String() != "1.2.3-beta+meta" {
+		t.Errorf("IncMajor: receiver was mutated, got %s", v)
+	}
+}
+
+func TestIncMinor(t *testing.T) {
+	v := mustNew(t, "1.2.3-beta+meta")
+	got := v.IncMinor()
+	if got.String() != "1.3.0" {
+		t.Errorf("IncMinor: want 1.3.0, got %s", got)
+	}
+}
+
+func TestIncPatch(t *testing.T) {
+	tests := map[string]struct {
+		version string
+		want    string
+	}{
+		"release":    {version: "1.2.3", want: "1.2.4"},
+		"prerelease": {version: "1.2.3-beta+meta", want: "1.2.3"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := mustNew(t, tt.version)
+			got := v.IncPatch()
+			if got.String() != tt.want {
+				t.Errorf("IncPatch(%q): want %q, got %q", tt.version, tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestWithPrerelease(t *testing.T) {
+	v := mustNew(t, "1.2.3+meta")
+	got, err := v.WithPrerelease("beta", "1")
+	if err != nil {
+		t.Fatalf("WithPrerelease: unexpected error: %v", err)
+	}
+	if want := "1.2.3-beta.1+meta"; got.String() != want {
+		t.Errorf("WithPrerelease: want %q, got %q", want, got.String())
+	}
+
+	_, err = v.WithPrerelease("be$ta")
+	if _, ok := err.(ParseError); !ok {
+		t.Errorf("WithPrerelease: want ParseError, got %v", err)
+	}
+}
+
+func TestWithMeta(t *testing.T) {
+	v := mustNew(t, "1.2.3-beta")
+	got, err := v.WithMeta("build.5")
+	if err != nil {
+		t.Fatalf("WithMeta: unexpected error: %v", err)
+	}
+	if want := "1.2.3-beta+build.5"; got.String() != want {
+		t.Errorf("WithMeta: want %q, got %q", want, got.String())
+	}
+
+	_, err = v.WithMeta("bad$meta")
+	if _, ok := err.(ParseError); !ok {
+		t.Errorf("WithMeta: want ParseError, got %v", err)
+	}
+}
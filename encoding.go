@@ -0,0 +1,87 @@
+package sem
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements json.Marshaler, encoding v as its canonical
+// semvar string. A nil v marshals to the JSON null literal.
+func (v *Version) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing a canonical semvar
+// string with New.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := New(s)
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding v as its
+// canonical semvar string. A nil v marshals to nil, matching Value's
+// nil-receiver handling.
+func (v *Version) MarshalText() ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing a canonical
+// semvar string with New.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := New(string(text))
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// Value implements driver.Valuer, encoding v as its canonical semvar string
+// for storage in a VARCHAR or TEXT column.
+func (v *Version) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements sql.Scanner, parsing a semvar string read back from a
+// VARCHAR or TEXT column. A nil src zeros the receiver.
+func (v *Version) Scan(src interface{}) error {
+	if src == nil {
+		*v = Version{}
+		return nil
+	}
+
+	var s string
+	switch t := src.(type) {
+	case string:
+		s = t
+	case []byte:
+		s = string(t)
+	default:
+		return fmt.Errorf("sem: cannot scan %T into Version", src)
+	}
+
+	parsed, err := New(s)
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
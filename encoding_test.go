@@ -0,0 +1,125 @@
+package sem
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVersionJSON(t *testing.T) {
+	v := mustNew(t, "1.2.3-beta.1+meta")
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Marshal: unexpected error: %v", err)
+	}
+	if want := `"1.2.3-beta.1+meta"`; string(data) != want {
+		t.Errorf("Marshal: want %s, got %s", want, data)
+	}
+
+	var got Version
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: unexpected error: %v", err)
+	}
+	if got.String() != v.String() {
+		t.Errorf("Unmarshal: want %q, got %q", v.String(), got.String())
+	}
+}
+
+func TestVersionMarshalNil(t *testing.T) {
+	var nilV *Version
+
+	data, err := nilV.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON on nil: unexpected error: %v", err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON on nil: want null, got %s", data)
+	}
+
+	text, err := nilV.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText on nil: unexpected error: %v", err)
+	}
+	if text != nil {
+		t.Errorf("MarshalText on nil: want nil, got %s", text)
+	}
+}
+
+func TestVersionJSONInvalid(t *testing.T) {
+	var v Version
+	err := json.Unmarshal([]byte(`"not-a-semvar"`), &v)
+	if _, ok := err.(ParseError); !ok {
+		if err != ErrBadSemVer {
+			t.Errorf("Unmarshal: want ParseError or ErrBadSemVer, got %v", err)
+		}
+	}
+}
+
+func TestVersionText(t *testing.T) {
+	v := mustNew(t, "1.2.3")
+
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: unexpected error: %v", err)
+	}
+	if string(text) != "1.2.3" {
+		t.Errorf("MarshalText: want 1.2.3, got %s", text)
+	}
+
+	var got Version
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText: unexpected error: %v", err)
+	}
+	if got.String() != v.String() {
+		t.Errorf("UnmarshalText: want %q, got %q", v.String(), got.String())
+	}
+}
+
+func TestVersionValue(t *testing.T) {
+	v := mustNew(t, "1.2.3")
+	val, err := v.Value()
+	if err != nil {
+		t.Fatalf("Value: unexpected error: %v", err)
+	}
+	if val != "1.2.3" {
+		t.Errorf("Value: want 1.2.3, got %v", val)
+	}
+
+	var nilV *Version
+	val, err = nilV.Value()
+	if err != nil || val != nil {
+		t.Errorf("Value on nil: want (nil, nil), got (%v, %v)", val, err)
+	}
+}
+
+func TestVersionScan(t *testing.T) {
+	tests := map[string]struct {
+		src     interface{}
+		want    string
+		wantErr bool
+	}{
+		"string":      {src: "1.2.3", want: "1.2.3"},
+		"bytes":       {src: []byte("1.2.3"), want: "1.2.3"},
+		"nil":         {src: nil, want: "0.0.0"},
+		"bad type":    {src: 123, wantErr: true},
+		"bad version": {src: "not-a-semvar", wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var v Version
+			err := v.Scan(tt.src)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Scan(%v): want error, got nil", tt.src)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v): unexpected error: %v", tt.src, err)
+			}
+			if v.String() != tt.want {
+				t.Errorf("Scan(%v): want %q, got %q", tt.src, tt.want, v.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package sem
+
+import "strings"
+
+// NewLenient parses s like New, but is more forgiving of common informal
+// forms: a leading "v" or "V" is stripped, as in golang.org/x/mod/semver's
+// "v1.2.3", and a missing minor or patch version is treated as zero
+// ("1" -> "1.0.0", "1.2" -> "1.2.0"). Any prerelease or meta suffix is
+// validated exactly as New validates it. New remains the strict,
+// spec-exact parser.
+func NewLenient(s string) (*Version, error) {
+	canon, err := canonicalize(s)
+	if err != nil {
+		return nil, err
+	}
+	return New(canon)
+}
+
+// Canonical parses s in lenient mode and returns its canonical
+// major.minor.patch[-pre][+meta] form. It is useful for normalizing
+// user-supplied version strings, such as from CLIs, git tags, or Go module
+// versions, before storage or comparison.
+func Canonical(s string) (string, error) {
+	v, err := NewLenient(s)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+// canonicalize strips a leading v/V prefix and pads a missing minor or
+// patch version with zero, leaving any prerelease or meta suffix untouched
+// for New to validate. Blank or missing normal components, such as from an
+// empty string or a bare "v", are rejected with ErrBadSemVer here rather
+// than left to surface as a raw strconv error out of New.
+func canonicalize(s string) (string, error) {
+	if len(s) > 0 && (s[0] == 'v' || s[0] == 'V') {
+		s = s[1:]
+	}
+	if s == "" {
+		return "", ErrBadSemVer
+	}
+
+	meta := ""
+	if i := strings.Index(s, "+"); i >= 0 {
+		meta = s[i:]
+		s = s[:i]
+	}
+
+	pre := ""
+	if i := strings.Index(s, "-"); i >= 0 {
+		pre = s[i:]
+		s = s[:i]
+	}
+
+	normal := strings.Split(s, ".")
+	for _, part := range normal {
+		if part == "" {
+			return "", ErrBadSemVer
+		}
+	}
+	for len(normal) < 3 {
+		normal = append(normal, "0")
+	}
+
+	return strings.Join(normal, ".") + pre + meta, nil
+}
@@ -0,0 +1,75 @@
+package sem
+
+import "testing"
+
+func TestNewLenient(t *testing.T) {
+	tests := map[string]struct {
+		version string
+		want    string
+	}{
+		"v prefix":        {version: "v1.2.3", want: "1.2.3"},
+		"capital v":       {version: "V1.2.3", want: "1.2.3"},
+		"major only":      {version: "1", want: "1.0.0"},
+		"major minor":     {version: "1.2", want: "1.2.0"},
+		"full":            {version: "1.2.3", want: "1.2.3"},
+		"prerelease":      {version: "v1.2-beta.1", want: "1.2.0-beta.1"},
+		"meta":            {version: "v1+build.5", want: "1.0.0+build.5"},
+		"prerelease meta": {version: "v1.2-rc.1+meta", want: "1.2.0-rc.1+meta"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewLenient(tt.version)
+			if err != nil {
+				t.Fatalf("NewLenient(%q): unexpected error: %v", tt.version, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewLenient(%q): want %q, got %q", tt.version, tt.want, got.String())
+			}
+		})
+	}
+}
+
+func TestNewLenientInvalid(t *testing.T) {
+	tests := map[string]string{
+		"too many normal components": "v1.2.3.4",
+		"empty":                      "",
+		"bare v prefix":              "v",
+		"bare dot":                   ".",
+		"blank normal component":     "1..2",
+		"bare prerelease":            "-beta",
+	}
+	for name, version := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewLenient(version); err != ErrBadSemVer {
+				t.Errorf("NewLenient(%q): want ErrBadSemVer, got %v", version, err)
+			}
+		})
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	got, err := Canonical("v1.2")
+	if err != nil {
+		t.Fatalf("Canonical: unexpected error: %v", err)
+	}
+	if want := "1.2.0"; got != want {
+		t.Errorf("Canonical: want %q, got %q", want, got)
+	}
+
+	if _, err := Canonical("not-a-version!!"); err == nil {
+		t.Errorf("Canonical: want error, got nil")
+	}
+
+	if _, err := Canonical(""); err != ErrBadSemVer {
+		t.Errorf("Canonical(\"\"): want ErrBadSemVer, got %v", err)
+	}
+}
+
+func TestNewStrictUnchanged(t *testing.T) {
+	if _, err := New("v1.2.3"); err == nil {
+		t.Errorf("New: want strict parser to reject v-prefix, got nil error")
+	}
+	if _, err := New("1.2"); err == nil {
+		t.Errorf("New: want strict parser to reject short version, got nil error")
+	}
+}
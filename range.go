@@ -0,0 +1,210 @@
+package sem
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrBadRange is returned when attempting to parse a range expression that
+// has no comparators, such as an empty string or an empty OR-group.
+var ErrBadRange = errors.New("range expression must contain at least one comparator")
+
+// Range is a version constraint expression: an OR of AND-groups of
+// comparators. A *Version satisfies a Range if it satisfies every
+// comparator in at least one of the AND-groups.
+type Range [][]comparator
+
+// comparator pairs a comparison operator with the version it compares
+// against.
+type comparator struct {
+	op  string
+	ver *Version
+}
+
+// ParseRange parses a constraint expression such as
+// ">=1.2.0 <2.0.0 || >=3.0.0-beta.1" into a Range. Comparators within an
+// AND-group may be separated by whitespace or commas; AND-groups are
+// separated by "||". Supported operators are =, !=, <, <=, >, >=, along with
+// the tilde (~1.2.3, equivalent to >=1.2.3 <1.3.0) and caret (^1.2.3,
+// equivalent to >=1.2.3 <2.0.0) shortcuts. A bare version with no operator
+// is treated as an exact match.
+func ParseRange(expr string) (Range, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, ErrBadRange
+	}
+
+	orParts := strings.Split(expr, "||")
+	r := make(Range, 0, len(orParts))
+	for _, orPart := range orParts {
+		fields := strings.FieldsFunc(orPart, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ','
+		})
+		if len(fields) == 0 {
+			return nil, ErrBadRange
+		}
+
+		var group []comparator
+		for _, field := range fields {
+			cs, err := parseComparator(field)
+			if err != nil {
+				return nil, err
+			}
+			group = append(group, cs...)
+		}
+		r = append(r, group)
+	}
+	return r, nil
+}
+
+// MustParseRange is like ParseRange but panics if expr cannot be parsed. It
+// is intended for use in variable initializations.
+func MustParseRange(expr string) Range {
+	r, err := ParseRange(expr)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// parseComparator parses a single comparator term, expanding the tilde and
+// caret shortcuts into their equivalent pair of comparators.
+func parseComparator(field string) ([]comparator, error) {
+	switch {
+	case strings.HasPrefix(field, ">="):
+		return comparatorFor(">=", field[2:])
+	case strings.HasPrefix(field, "<="):
+		return comparatorFor("<=", field[2:])
+	case strings.HasPrefix(field, "!="):
+		return comparatorFor("!=", field[2:])
+	case strings.HasPrefix(field, ">"):
+		return comparatorFor(">", field[1:])
+	case strings.HasPrefix(field, "<"):
+		return comparatorFor("<", field[1:])
+	case strings.HasPrefix(field, "="):
+		return comparatorFor("=", field[1:])
+	case strings.HasPrefix(field, "~"):
+		return tildeComparators(field[1:])
+	case strings.HasPrefix(field, "^"):
+		return caretComparators(field[1:])
+	default:
+		return comparatorFor("=", field)
+	}
+}
+
+func comparatorFor(op, verStr string) ([]comparator, error) {
+	v, err := New(verStr)
+	if err != nil {
+		return nil, err
+	}
+	return []comparator{{op: op, ver: v}}, nil
+}
+
+// tildeComparators expands "~1.2.3" into ">=1.2.3 <1.3.0": patch-level
+// changes are allowed if a minor version is specified.
+func tildeComparators(verStr string) ([]comparator, error) {
+	v, err := New(verStr)
+	if err != nil {
+		return nil, err
+	}
+	upper := &Version{Normal: [3]int{v.Normal[0], v.Normal[1] + 1, 0}}
+	return []comparator{
+		{op: ">=", ver: v},
+		{op: "<", ver: upper},
+	}, nil
+}
+
+// caretComparators expands "^1.2.3" into ">=1.2.3 <2.0.0": changes that do
+// not modify the left-most non-zero digit are allowed. For a 0.x release
+// that means the minor version is the left-most non-zero digit
+// ("^0.2.3" -> ">=0.2.3 <0.3.0"), and for a 0.0.x release it's the patch
+// version ("^0.0.3" -> ">=0.0.3 <0.0.4").
+func caretComparators(verStr string) ([]comparator, error) {
+	v, err := New(verStr)
+	if err != nil {
+		return nil, err
+	}
+	var upper *Version
+	switch {
+	case v.Normal[0] > 0:
+		upper = &Version{Normal: [3]int{v.Normal[0] + 1, 0, 0}}
+	case v.Normal[1] > 0:
+		upper = &Version{Normal: [3]int{0, v.Normal[1] + 1, 0}}
+	default:
+		upper = &Version{Normal: [3]int{0, 0, v.Normal[2] + 1}}
+	}
+	return []comparator{
+		{op: ">=", ver: v},
+		{op: "<", ver: upper},
+	}, nil
+}
+
+// Contains reports whether v satisfies the Range: every comparator in at
+// least one AND-group must be satisfied.
+//
+// A prerelease version only satisfies a group if that group contains a
+// comparator bound to the same major.minor.patch triple that also carries a
+// prerelease, matching the common npm/blang semver behavior of excluding
+// prereleases from ranges that were not written with them in mind.
+func (r Range) Contains(v *Version) bool {
+	for _, group := range r {
+		if groupContains(group, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func groupContains(group []comparator, v *Version) bool {
+	if len(v.Prerelease) > 0 {
+		allowed := false
+		for _, c := range group {
+			if c.ver.Normal == v.Normal && len(c.ver.Prerelease) > 0 {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	for _, c := range group {
+		if !c.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c comparator) matches(v *Version) bool {
+	cmp := compare(v, c.ver)
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+// compare returns -1 if a has lower precedence than b, 0 if they have equal
+// precedence, and 1 if a has higher precedence than b, using the same
+// precedence rules as IsAtLeast.
+func compare(a, b *Version) int {
+	switch {
+	case a.IsAtLeast(b) && b.IsAtLeast(a):
+		return 0
+	case a.IsAtLeast(b):
+		return 1
+	default:
+		return -1
+	}
+}
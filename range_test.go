@@ -0,0 +1,150 @@
+package sem
+
+import "testing"
+
+func TestParseRangeErrors(t *testing.T) {
+	tests := map[string]struct {
+		expr string
+	}{
+		"empty":          {expr: ""},
+		"empty or-group": {expr: ">=1.0.0 || "},
+		"bad version":    {expr: ">=a.b.c"},
+		"bad tilde":      {expr: "~a.b.c"},
+		"bad caret":      {expr: "^a.b.c"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseRange(tt.expr); err == nil {
+				t.Errorf("ParseRange(%q): want error, got nil", tt.expr)
+			}
+		})
+	}
+}
+
+func TestMustParseRangePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseRange: want panic on bad input")
+		}
+	}()
+	MustParseRange("")
+}
+
+func TestRangeContains(t *testing.T) {
+	tests := map[string]struct {
+		expr    string
+		version string
+		want    bool
+	}{
+		"simple exact match": {
+			expr:    "1.2.3",
+			version: "1.2.3",
+			want:    true,
+		},
+		"simple exact mismatch": {
+			expr:    "1.2.3",
+			version: "1.2.4",
+			want:    false,
+		},
+		"and group satisfied": {
+			expr:    ">=1.2.0 <2.0.0",
+			version: "1.5.0",
+			want:    true,
+		},
+		"and group not satisfied": {
+			expr:    ">=1.2.0 <2.0.0",
+			version: "2.0.0",
+			want:    false,
+		},
+		"comma separated and group": {
+			expr:    ">=1.2.0, <2.0.0",
+			version: "1.5.0",
+			want:    true,
+		},
+		"or group second branch": {
+			expr:    ">=1.2.0 <2.0.0 || >=3.0.0-beta.1",
+			version: "3.0.0-beta.2",
+			want:    true,
+		},
+		"or group neither branch": {
+			expr:    ">=1.2.0 <2.0.0 || >=3.0.0-beta.1",
+			version: "2.5.0",
+			want:    false,
+		},
+		"not equal": {
+			expr:    "!=1.2.3",
+			version: "1.2.3",
+			want:    false,
+		},
+		"tilde patch allowed": {
+			expr:    "~1.2.3",
+			version: "1.2.9",
+			want:    true,
+		},
+		"tilde minor bump excluded": {
+			expr:    "~1.2.3",
+			version: "1.3.0",
+			want:    false,
+		},
+		"caret minor and patch allowed": {
+			expr:    "^1.2.3",
+			version: "1.9.9",
+			want:    true,
+		},
+		"caret major bump excluded": {
+			expr:    "^1.2.3",
+			version: "2.0.0",
+			want:    false,
+		},
+		"prerelease excluded without matching bound": {
+			expr:    ">=1.0.0 <2.0.0",
+			version: "1.5.0-alpha",
+			want:    false,
+		},
+		"prerelease included with matching bound": {
+			expr:    ">=1.5.0-alpha <2.0.0",
+			version: "1.5.0-alpha.1",
+			want:    true,
+		},
+		"caret 0.x patch allowed": {
+			expr:    "^0.2.3",
+			version: "0.2.9",
+			want:    true,
+		},
+		"caret 0.x next minor excluded": {
+			expr:    "^0.2.3",
+			version: "0.3.0",
+			want:    false,
+		},
+		"caret 0.x minor bump excluded": {
+			expr:    "^0.2.3",
+			version: "0.5.0",
+			want:    false,
+		},
+		"caret 0.0.x patch allowed": {
+			expr:    "^0.0.3",
+			version: "0.0.3",
+			want:    true,
+		},
+		"caret 0.0.x patch bump excluded": {
+			expr:    "^0.0.3",
+			version: "0.0.4",
+			want:    false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, err := ParseRange(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseRange(%q): unexpected error: %v", tt.expr, err)
+			}
+			v, err := New(tt.version)
+			if err != nil {
+				t.Fatalf("New(%q): unexpected error: %v", tt.version, err)
+			}
+			if got := r.Contains(v); got != tt.want {
+				t.Errorf("Contains(%q in %q): want %v, got %v", tt.version, tt.expr, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package sem
+
+import "sort"
+
+// Versions attaches the methods of sort.Interface to a slice of *Version,
+// ordering from lowest to highest precedence according to the same rules as
+// IsAtLeast.
+type Versions []*Version
+
+// Len implements sort.Interface.
+func (vs Versions) Len() int {
+	return len(vs)
+}
+
+// Less implements sort.Interface.
+func (vs Versions) Less(i, j int) bool {
+	return compare(vs[i], vs[j]) < 0
+}
+
+// Swap implements sort.Interface.
+func (vs Versions) Swap(i, j int) {
+	vs[i], vs[j] = vs[j], vs[i]
+}
+
+// Sort sorts vs in place from lowest to highest precedence.
+func Sort(vs []*Version) {
+	sort.Sort(Versions(vs))
+}
+
+// Latest returns the highest precedence version in vs, or nil if vs is
+// empty.
+func Latest(vs []*Version) *Version {
+	var latest *Version
+	for _, v := range vs {
+		if latest == nil || compare(v, latest) > 0 {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// LatestStable returns the highest precedence version in vs that has no
+// prerelease component, or nil if vs contains no such version.
+func LatestStable(vs []*Version) *Version {
+	var latest *Version
+	for _, v := range vs {
+		if len(v.Prerelease) > 0 {
+			continue
+		}
+		if latest == nil || compare(v, latest) > 0 {
+			latest = v
+		}
+	}
+	return latest
+}
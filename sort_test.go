@@ -0,0 +1,74 @@
+package sem
+
+import "testing"
+
+func mustNew(t *testing.T, s string) *Version {
+	t.Helper()
+	v, err := New(s)
+	if err != nil {
+		t.Fatalf("New(%q): unexpected error: %v", s, err)
+	}
+	return v
+}
+
+func TestSort(t *testing.T) {
+	vs := []*Version{
+		mustNew(t, "1.2.3"),
+		mustNew(t, "1.0.0-beta.2"),
+		mustNew(t, "2.0.0+meta"),
+		mustNew(t, "1.0.0-alpha"),
+		mustNew(t, "1.0.0"),
+	}
+	Sort(vs)
+
+	want := []string{
+		"1.0.0-alpha",
+		"1.0.0-beta.2",
+		"1.0.0",
+		"1.2.3",
+		"2.0.0+meta",
+	}
+	for i, v := range vs {
+		if got := v.String(); got != want[i] {
+			t.Errorf("position %d: want %q, got %q", i, want[i], got)
+		}
+	}
+}
+
+func TestLatest(t *testing.T) {
+	vs := []*Version{
+		mustNew(t, "1.0.0"),
+		mustNew(t, "1.2.3"),
+		mustNew(t, "1.2.3-beta"),
+	}
+	got := Latest(vs)
+	if got == nil || got.String() != "1.2.3" {
+		t.Errorf("Latest: want 1.2.3, got %v", got)
+	}
+}
+
+func TestLatestStable(t *testing.T) {
+	vs := []*Version{
+		mustNew(t, "1.0.0"),
+		mustNew(t, "2.0.0-rc.1"),
+		mustNew(t, "1.2.3"),
+	}
+	got := LatestStable(vs)
+	if got == nil || got.String() != "1.2.3" {
+		t.Errorf("LatestStable: want 1.2.3, got %v", got)
+	}
+
+	onlyPrerelease := []*Version{mustNew(t, "1.0.0-alpha")}
+	if got := LatestStable(onlyPrerelease); got != nil {
+		t.Errorf("LatestStable: want nil, got %v", got)
+	}
+}
+
+func TestLatestEmpty(t *testing.T) {
+	if got := Latest(nil); got != nil {
+		t.Errorf("Latest(nil): want nil, got %v", got)
+	}
+	if got := LatestStable(nil); got != nil {
+		t.Errorf("LatestStable(nil): want nil, got %v", got)
+	}
+}